@@ -0,0 +1,30 @@
+package clients
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// APIError is a generalized error type for wrapping non-2xx responses
+// from any git host backend (GitHub, Gitea/Forgejo, GitLab, ...).
+type APIError struct {
+	Source     string `json:"-"`
+	URL        string `json:"-"`
+	StatusCode int    `json:"-"`
+	Message    string `json:"message"`
+}
+
+// NewAPIError unmarshals a provider's error body into an APIError.
+// If the body can't be unmarshalled, a generic message is used instead.
+func NewAPIError(source string, url string, data []byte, statusCode int) *APIError {
+	e := APIError{Source: source, URL: url, StatusCode: statusCode}
+	if err := json.Unmarshal(data, &e); err != nil {
+		e.Message = "not able to unmarshal error response"
+	}
+	return &e
+}
+
+// Error satisfies the error interface.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%v error %v: %v | URL: %v", e.Source, e.StatusCode, e.Message, e.URL)
+}
@@ -1,9 +1,9 @@
 package github
 
 import (
-	"encoding/json"
-	"fmt"
 	"time"
+
+	"github.com/tunedmystic/commits.lol/app/clients"
 )
 
 // CommitSearchResponse ...
@@ -47,23 +47,11 @@ type Repository struct {
 	Owner User   `json:"owner"`
 }
 
-// APIError ...
-type APIError struct {
-	URL        string `json:"-"`
-	StatusCode int    `json:"-"`
-	Message    string `json:"message"`
-}
+// APIError is a GitHub-flavored alias of clients.APIError, kept so callers
+// can keep referencing github.APIError / github.NewAPIError.
+type APIError = clients.APIError
 
-// NewAPIError ...
+// NewAPIError wraps a GitHub error response body in an APIError.
 func NewAPIError(url string, data []byte, statusCode int) *APIError {
-	e := APIError{URL: url, StatusCode: statusCode}
-	if err := json.Unmarshal(data, &e); err != nil {
-		e.Message = "not able to unmarshal error response"
-	}
-	return &e
-}
-
-// Error satisfies the error interface.
-func (e *APIError) Error() string {
-	return fmt.Sprintf("github error %v: %v | URL: %v", e.StatusCode, e.Message, e.URL)
+	return clients.NewAPIError("github", url, data, statusCode)
 }
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/tunedmystic/commits.lol/app/models"
+)
+
+// Database is satisfied by every backend (SqliteDB, PostgresDB, ...).
+type Database interface {
+	RecentCommits() ([]*models.GitCommit, error)
+
+	GetOrCreateSource(name string) (*models.GitSource, error)
+	GetOrCreateUser(user *models.GitUser) error
+	GetOrCreateRepo(repo *models.GitRepo) error
+	GetOrCreateCommit(commit *models.GitCommit) error
+
+	Migrate(ctx context.Context) error
+	Rollback(ctx context.Context, steps int) error
+}
+
+// Config holds the driver and connection details used by New to pick
+// and construct a Database backend.
+type Config struct {
+	Driver string // "sqlite" or "postgres"
+	DSN    string
+}
+
+// ConfigFromEnv builds a Config from the DB_DRIVER and DB_DSN environment
+// variables.
+func ConfigFromEnv() Config {
+	return Config{
+		Driver: os.Getenv("DB_DRIVER"),
+		DSN:    os.Getenv("DB_DSN"),
+	}
+}
+
+// New picks a Database implementation based on cfg.Driver, connects using
+// cfg.DSN, and returns it.
+func New(cfg Config) (Database, error) {
+	switch cfg.Driver {
+	case "sqlite":
+		return NewSqliteDB(cfg.DSN), nil
+	case "postgres":
+		return NewPostgresDB(cfg.DSN), nil
+	default:
+		return nil, fmt.Errorf("unknown db driver: %v", cfg.Driver)
+	}
+}
@@ -0,0 +1,222 @@
+package db
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+//go:embed migrations/sqlite/*.sql migrations/postgres/*.sql
+var migrationsFS embed.FS
+
+// migration is a single numbered up/down SQL pair.
+type migration struct {
+	version int
+	name    string
+	up      string
+	down    string
+}
+
+// migrator applies and reverts the embedded migrations for a dialect
+// ("sqlite" or "postgres") against a *sqlx.DB. SqliteDB and PostgresDB
+// each embed a migrator configured for their own dialect, so both get
+// Migrate and Rollback for free.
+type migrator struct {
+	db      *sqlx.DB
+	dialect string
+}
+
+// loadMigrations reads the embedded migration files for the migrator's
+// dialect and returns them sorted by version, ascending.
+func (m *migrator) loadMigrations() ([]migration, error) {
+	dir := "migrations/" + m.dialect
+
+	entries, err := fs.ReadDir(migrationsFS, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]*migration{}
+
+	for _, entry := range entries {
+		version, name, direction, err := parseMigrationFilename(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := migrationsFS.ReadFile(dir + "/" + entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &migration{version: version, name: name}
+			byVersion[version] = mig
+		}
+
+		switch direction {
+		case "up":
+			mig.up = string(data)
+		case "down":
+			mig.down = string(data)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		migrations = append(migrations, *mig)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].version < migrations[j].version
+	})
+
+	return migrations, nil
+}
+
+// parseMigrationFilename splits "002_add_git_source.up.sql" into its
+// version, name and direction ("up" or "down").
+func parseMigrationFilename(filename string) (version int, name string, direction string, err error) {
+	base := strings.TrimSuffix(filename, ".sql")
+
+	parts := strings.SplitN(base, ".", 2)
+	if len(parts) != 2 {
+		return 0, "", "", fmt.Errorf("malformed migration filename: %v", filename)
+	}
+	base, direction = parts[0], parts[1]
+
+	parts = strings.SplitN(base, "_", 2)
+	if len(parts) != 2 {
+		return 0, "", "", fmt.Errorf("malformed migration filename: %v", filename)
+	}
+
+	version, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, "", "", fmt.Errorf("malformed migration version in %v: %w", filename, err)
+	}
+
+	return version, parts[1], direction, nil
+}
+
+// Migrate creates the schema_migrations table if needed, and applies every
+// pending .up.sql migration in order, each inside its own transaction.
+func (m *migrator) Migrate(ctx context.Context) error {
+	if _, err := m.db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);`); err != nil {
+		return fmt.Errorf("error creating schema_migrations table: %w", err)
+	}
+
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	migrations, err := m.loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	for _, mig := range migrations {
+		if applied[mig.version] {
+			continue
+		}
+
+		tx, err := m.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, mig.up); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("error applying migration %v_%v: %w", mig.version, mig.name, err)
+		}
+
+		insert := m.db.Rebind(`INSERT INTO schema_migrations (version) VALUES (?);`)
+		if _, err := tx.ExecContext(ctx, insert, mig.version); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("error recording migration %v_%v: %w", mig.version, mig.name, err)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Rollback reverts the most recently applied `steps` migrations, in
+// reverse order, each inside its own transaction.
+func (m *migrator) Rollback(ctx context.Context, steps int) error {
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	migrations, err := m.loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].version > migrations[j].version
+	})
+
+	for _, mig := range migrations {
+		if steps <= 0 {
+			break
+		}
+		if !applied[mig.version] {
+			continue
+		}
+
+		tx, err := m.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, mig.down); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("error reverting migration %v_%v: %w", mig.version, mig.name, err)
+		}
+
+		del := m.db.Rebind(`DELETE FROM schema_migrations WHERE version = ?;`)
+		if _, err := tx.ExecContext(ctx, del, mig.version); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("error unrecording migration %v_%v: %w", mig.version, mig.name, err)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+
+		steps--
+	}
+
+	return nil
+}
+
+// appliedVersions returns the set of migration versions already applied.
+func (m *migrator) appliedVersions(ctx context.Context) (map[int]bool, error) {
+	var versions []int
+	if err := m.db.SelectContext(ctx, &versions, `SELECT version FROM schema_migrations;`); err != nil {
+		return nil, fmt.Errorf("error reading schema_migrations: %w", err)
+	}
+
+	applied := make(map[int]bool, len(versions))
+	for _, v := range versions {
+		applied[v] = true
+	}
+
+	return applied, nil
+}
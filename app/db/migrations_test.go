@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // sqlite
+)
+
+func newTestMigrator(t *testing.T) *migrator {
+	t.Helper()
+
+	name := filepath.Join(t.TempDir(), "migrations.db")
+	conn := sqlx.MustConnect("sqlite3", name)
+	t.Cleanup(func() { conn.Close() })
+
+	return &migrator{db: conn, dialect: "sqlite"}
+}
+
+func TestMigrator_Migrate_IsIdempotent(t *testing.T) {
+	m := newTestMigrator(t)
+	ctx := context.Background()
+
+	if err := m.Migrate(ctx); err != nil {
+		t.Fatalf("first Migrate() failed: %v", err)
+	}
+	if err := m.Migrate(ctx); err != nil {
+		t.Fatalf("second Migrate() failed: %v", err)
+	}
+
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		t.Fatalf("appliedVersions() failed: %v", err)
+	}
+
+	migrations, err := m.loadMigrations()
+	if err != nil {
+		t.Fatalf("loadMigrations() failed: %v", err)
+	}
+	if len(applied) != len(migrations) {
+		t.Fatalf("applied %d migrations, want %d", len(applied), len(migrations))
+	}
+
+	var count int
+	if err := m.db.Get(&count, `SELECT COUNT(*) FROM git_source;`); err != nil {
+		t.Fatalf("error counting git_source rows: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("git_source has %d rows after re-running Migrate, want 1 (seed row shouldn't be re-inserted)", count)
+	}
+}
+
+func TestMigrator_Rollback(t *testing.T) {
+	m := newTestMigrator(t)
+	ctx := context.Background()
+
+	if err := m.Migrate(ctx); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	migrations, err := m.loadMigrations()
+	if err != nil {
+		t.Fatalf("loadMigrations() failed: %v", err)
+	}
+	total := len(migrations)
+	if total < 2 {
+		t.Fatalf("expected at least 2 migrations to exercise a partial rollback, got %d", total)
+	}
+
+	if err := m.Rollback(ctx, 1); err != nil {
+		t.Fatalf("Rollback(1) failed: %v", err)
+	}
+
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		t.Fatalf("appliedVersions() failed: %v", err)
+	}
+	if len(applied) != total-1 {
+		t.Fatalf("applied %d migrations after rolling back 1, want %d", len(applied), total-1)
+	}
+
+	if err := m.Rollback(ctx, total); err != nil {
+		t.Fatalf("Rollback(%d) failed: %v", total, err)
+	}
+
+	applied, err = m.appliedVersions(ctx)
+	if err != nil {
+		t.Fatalf("appliedVersions() failed: %v", err)
+	}
+	if len(applied) != 0 {
+		t.Fatalf("applied %d migrations after rolling back everything, want 0", len(applied))
+	}
+}
+
+func TestParseMigrationFilename(t *testing.T) {
+	tests := []struct {
+		filename      string
+		wantVersion   int
+		wantName      string
+		wantDirection string
+		wantErr       bool
+	}{
+		{"001_init.up.sql", 1, "init", "up", false},
+		{"002_add_git_source.down.sql", 2, "add_git_source", "down", false},
+		{"init.up.sql", 0, "", "", true},
+		{"001.up.sql", 0, "", "", true},
+		{"001_init.sql", 0, "", "", true},
+		{"abc_init.up.sql", 0, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			version, name, direction, err := parseMigrationFilename(tt.filename)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error for %q, got nil", tt.filename)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error for %q: %v", tt.filename, err)
+			}
+			if version != tt.wantVersion || name != tt.wantName || direction != tt.wantDirection {
+				t.Errorf("parseMigrationFilename(%q) = (%v, %v, %v), want (%v, %v, %v)",
+					tt.filename, version, name, direction, tt.wantVersion, tt.wantName, tt.wantDirection)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq" // postgres
+
+	postgresgen "github.com/tunedmystic/commits.lol/app/db/gen/postgres"
+	"github.com/tunedmystic/commits.lol/app/models"
+)
+
+// PostgresDB is a postgres-backed type that implements the Database
+// interface. It is a thin wrapper around sqlc-generated Queries; the
+// actual SQL lives in app/db/queries/postgres and is regenerated with
+// `sqlc generate`.
+type PostgresDB struct {
+	DB *sqlx.DB
+
+	queries *postgresgen.Queries
+
+	migrator
+}
+
+// NewPostgresDB connects to the database, applies any pending migrations,
+// and returns a new *PostgresDB type.
+func NewPostgresDB(dsn string) *PostgresDB {
+	conn := sqlx.MustConnect("postgres", dsn)
+
+	pdb := PostgresDB{
+		DB:       conn,
+		queries:  postgresgen.New(conn),
+		migrator: migrator{db: conn, dialect: "postgres"},
+	}
+
+	if err := pdb.Migrate(context.Background()); err != nil {
+		panic(fmt.Sprintf("error migrating database: %v", err))
+	}
+
+	return &pdb
+}
+
+// RecentCommits returns the most recent commits.
+func (p *PostgresDB) RecentCommits() ([]*models.GitCommit, error) {
+	rows, err := p.queries.RecentCommits(context.Background(), recentCommitsSince)
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([]*models.GitCommit, 0, len(rows))
+	for _, row := range rows {
+		commits = append(commits, &models.GitCommit{
+			ID:       int(row.GitCommit.ID),
+			SourceID: int(row.GitCommit.SourceID),
+			AuthorID: int(row.GitCommit.AuthorID),
+			RepoID:   int(row.GitCommit.RepoID),
+			Message:  row.GitCommit.Message,
+			SHA:      row.GitCommit.Sha,
+			URL:      row.GitCommit.Url,
+			Date:     row.GitCommit.Date,
+			Author: &models.GitUser{
+				ID:        int(row.GitUser.ID),
+				SourceID:  int(row.GitUser.SourceID),
+				Username:  row.GitUser.Username,
+				URL:       row.GitUser.Url,
+				AvatarURL: row.GitUser.AvatarUrl,
+			},
+		})
+	}
+
+	return commits, nil
+}
+
+// GetOrCreateSource is a convenience method to get the git_source row for
+// the given name, or create it if it doesn't exist, keyed on the name
+// unique constraint. The returned ID is the value the searchers in
+// app/sources take as their sourceID argument.
+func (p *PostgresDB) GetOrCreateSource(name string) (*models.GitSource, error) {
+	row, err := p.queries.GetOrCreateSource(context.Background(), name)
+	if err != nil {
+		return nil, fmt.Errorf("error upserting source: %v", err)
+	}
+
+	return &models.GitSource{ID: int(row.ID), Name: row.Name}, nil
+}
+
+// GetOrCreateUser is a convenience method to get the provided User,
+// or create it if it doesn't exist, keyed on the URL unique constraint.
+func (p *PostgresDB) GetOrCreateUser(user *models.GitUser) error {
+	row, err := p.queries.GetOrCreateUser(context.Background(), postgresgen.GetOrCreateUserParams{
+		SourceID:  int32(user.SourceID),
+		Username:  user.Username,
+		Url:       user.URL,
+		AvatarUrl: user.AvatarURL,
+	})
+	if err != nil {
+		return fmt.Errorf("error upserting user: %v", err)
+	}
+
+	user.ID = int(row.ID)
+	return nil
+}
+
+// GetOrCreateRepo is a convenience method to get the provided Repo,
+// or create it if it doesn't exist, keyed on the URL unique constraint.
+func (p *PostgresDB) GetOrCreateRepo(repo *models.GitRepo) error {
+	row, err := p.queries.GetOrCreateRepo(context.Background(), postgresgen.GetOrCreateRepoParams{
+		SourceID:    int32(repo.SourceID),
+		Name:        repo.Name,
+		Description: repo.Description,
+		Url:         repo.URL,
+	})
+	if err != nil {
+		return fmt.Errorf("error upserting repo: %v", err)
+	}
+
+	repo.ID = int(row.ID)
+	return nil
+}
+
+// GetOrCreateCommit is a convenience method to get the provided Commit,
+// or create it if it doesn't exist, keyed on the URL unique constraint.
+func (p *PostgresDB) GetOrCreateCommit(commit *models.GitCommit) error {
+	row, err := p.queries.GetOrCreateCommit(context.Background(), postgresgen.GetOrCreateCommitParams{
+		SourceID: int32(commit.SourceID),
+		AuthorID: int32(commit.AuthorID),
+		RepoID:   int32(commit.RepoID),
+		Message:  commit.Message,
+		Sha:      commit.SHA,
+		Url:      commit.URL,
+		Date:     commit.Date,
+	})
+	if err != nil {
+		return fmt.Errorf("error upserting commit: %v", err)
+	}
+
+	commit.ID = int(row.ID)
+	return nil
+}
+
+// ------------------------------------------------------------------
+
+// Ensure the PostgresDB type satisfies the Database interface.
+var _ Database = &PostgresDB{}
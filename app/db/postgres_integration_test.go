@@ -0,0 +1,115 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/tunedmystic/commits.lol/app/db"
+	"github.com/tunedmystic/commits.lol/app/models"
+)
+
+// TestPostgresDB_RecentCommits runs the whole RecentCommits path -
+// GetOrCreateUser, GetOrCreateRepo, GetOrCreateCommit, then RecentCommits -
+// against a real Postgres container, to exercise the ON CONFLICT ...
+// RETURNING upserts that sqlite-only tests can't cover.
+func TestPostgresDB_RecentCommits(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:16-alpine",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "commits",
+				"POSTGRES_PASSWORD": "commits",
+				"POSTGRES_DB":       "commits",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp").WithStartupTimeout(30 * time.Second),
+		},
+		Started: true,
+	})
+	if err != nil {
+		t.Fatalf("error starting postgres container: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Logf("error terminating postgres container: %v", err)
+		}
+	})
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		t.Fatalf("error getting container host: %v", err)
+	}
+
+	port, err := container.MappedPort(ctx, "5432")
+	if err != nil {
+		t.Fatalf("error getting container port: %v", err)
+	}
+
+	dsn := "postgres://commits:commits@" + host + ":" + port.Port() + "/commits?sslmode=disable"
+	pdb := db.NewPostgresDB(dsn)
+
+	user := models.GitUser{
+		SourceID:  1,
+		Username:  "octocat",
+		URL:       "https://github.com/octocat",
+		AvatarURL: "https://github.com/octocat.png",
+	}
+	if err := pdb.GetOrCreateUser(&user); err != nil {
+		t.Fatalf("error creating user: %v", err)
+	}
+
+	repo := models.GitRepo{
+		SourceID:    1,
+		Name:        "hello-world",
+		Description: "My first repository",
+		URL:         "https://github.com/octocat/hello-world",
+	}
+	if err := pdb.GetOrCreateRepo(&repo); err != nil {
+		t.Fatalf("error creating repo: %v", err)
+	}
+
+	commit := models.GitCommit{
+		SourceID: 1,
+		AuthorID: user.ID,
+		RepoID:   repo.ID,
+		Message:  "Initial commit",
+		SHA:      "abc123",
+		URL:      "https://github.com/octocat/hello-world/commit/abc123",
+		Date:     time.Now().UTC(),
+	}
+	if err := pdb.GetOrCreateCommit(&commit); err != nil {
+		t.Fatalf("error creating commit: %v", err)
+	}
+
+	// Re-upserting the same commit URL should not create a duplicate row
+	// or error, exercising the ON CONFLICT (url) DO UPDATE path.
+	if err := pdb.GetOrCreateCommit(&commit); err != nil {
+		t.Fatalf("error re-upserting commit: %v", err)
+	}
+
+	commits, err := pdb.RecentCommits()
+	if err != nil {
+		t.Fatalf("error fetching recent commits: %v", err)
+	}
+
+	found := false
+	for _, c := range commits {
+		if c.URL == commit.URL {
+			found = true
+			if c.Author == nil || c.Author.Username != user.Username {
+				t.Errorf("expected commit author %v, got %v", user.Username, c.Author)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("expected RecentCommits to include commit %v", commit.URL)
+	}
+}
@@ -1,170 +1,169 @@
 package db
 
 import (
-	"database/sql"
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/mattn/go-sqlite3" // sqlite
 
+	sqlitegen "github.com/tunedmystic/commits.lol/app/db/gen/sqlite"
 	"github.com/tunedmystic/commits.lol/app/models"
 )
 
+// recentCommitsSince is the cutoff used by RecentCommits.
+var recentCommitsSince = time.Date(2015, time.September, 2, 0, 0, 0, 0, time.UTC)
+
 // SqliteDB is an sqlite-backed type that implements the Database interface.
+// It is a thin wrapper around sqlc-generated Queries; the actual SQL lives
+// in app/db/queries/sqlite and is regenerated with `sqlc generate`.
+//
+// SQLite only allows one writer at a time, so writes (GetOrCreate*,
+// migrations) go through DB, a single-connection handle running in WAL
+// mode. Reads (RecentCommits) go through ReadDB, a separate handle with a
+// larger pool, so the ingest job's writes never block the web handler's
+// reads with a "database is locked" error.
 type SqliteDB struct {
-	DB *sqlx.DB
+	DB     *sqlx.DB
+	ReadDB *sqlx.DB
+
+	queries     *sqlitegen.Queries
+	readQueries *sqlitegen.Queries
+
+	migrator
 }
 
-// NewSqliteDB connects to the database, and returns a new *SqliteDB type.
+// NewSqliteDB connects to the database, applies any pending migrations,
+// and returns a new *SqliteDB type.
 func NewSqliteDB(name string) *SqliteDB {
+	writeConn := sqlx.MustConnect("sqlite3", name)
+	writeConn.SetMaxOpenConns(1)
+
+	for _, pragma := range []string{
+		`PRAGMA journal_mode=WAL;`,
+		`PRAGMA synchronous=NORMAL;`,
+		`PRAGMA busy_timeout=5000;`,
+	} {
+		if _, err := writeConn.Exec(pragma); err != nil {
+			panic(fmt.Sprintf("error setting %v: %v", pragma, err))
+		}
+	}
+
+	readConn := sqlx.MustConnect("sqlite3", fmt.Sprintf("file:%v?mode=ro", name))
+	readConn.SetMaxOpenConns(4)
+
 	sdb := SqliteDB{
-		DB: sqlx.MustConnect("sqlite3", name),
+		DB:          writeConn,
+		ReadDB:      readConn,
+		queries:     sqlitegen.New(writeConn),
+		readQueries: sqlitegen.New(readConn),
+		migrator:    migrator{db: writeConn, dialect: "sqlite"},
 	}
+
+	if err := sdb.Migrate(context.Background()); err != nil {
+		panic(fmt.Sprintf("error migrating database: %v", err))
+	}
+
 	return &sdb
 }
 
 // RecentCommits returns the most recent commits.
 func (s *SqliteDB) RecentCommits() ([]*models.GitCommit, error) {
-	commits := []*models.GitCommit{}
-
-	sql := `
-		SELECT
-			c.*,
-
-			u.id AS "author.id",
-			u.source_id AS "author.source_id",
-			u.username AS "author.username",
-			u.url AS "author.url",
-			u.avatar_url AS "author.avatar_url"
-
-		FROM git_commit c
-		INNER JOIN git_user u on u.id = c.author_id
-		WHERE c.date > '2015-09-02';`
-
-	if err := s.DB.Select(&commits, sql); err != nil {
+	rows, err := s.readQueries.RecentCommits(context.Background(), recentCommitsSince)
+	if err != nil {
 		return nil, err
 	}
 
-	return commits, nil
-}
-
-// GetUserID retrieves a User ID, using the URL as the unique constraint.
-func (s *SqliteDB) GetUserID(user *models.GitUser) error {
-	sql := `SELECT id FROM git_user WHERE url = ?;`
+	commits := make([]*models.GitCommit, 0, len(rows))
+	for _, row := range rows {
+		commits = append(commits, &models.GitCommit{
+			ID:       int(row.GitCommit.ID),
+			SourceID: int(row.GitCommit.SourceID),
+			AuthorID: int(row.GitCommit.AuthorID),
+			RepoID:   int(row.GitCommit.RepoID),
+			Message:  row.GitCommit.Message,
+			SHA:      row.GitCommit.Sha,
+			URL:      row.GitCommit.Url,
+			Date:     row.GitCommit.Date,
+			Author: &models.GitUser{
+				ID:        int(row.GitUser.ID),
+				SourceID:  int(row.GitUser.SourceID),
+				Username:  row.GitUser.Username,
+				URL:       row.GitUser.Url,
+				AvatarURL: row.GitUser.AvatarUrl,
+			},
+		})
+	}
 
-	return s.DB.QueryRow(sql, user.URL).Scan(&user.ID)
+	return commits, nil
 }
 
-// CreateUser inserts a new User row and returns the ID.
-func (s *SqliteDB) CreateUser(user *models.GitUser) error {
-	sql := `
-		INSERT INTO git_user ("source_id", "username", "url", "avatar_url")
-		VALUES (:source_id, :username, :url, :avatar_url);`
-
-	row, err := s.DB.NamedExec(sql, user)
-
+// GetOrCreateSource is a convenience method to get the git_source row for
+// the given name, or create it if it doesn't exist, keyed on the name
+// unique constraint. The returned ID is the value the searchers in
+// app/sources take as their sourceID argument.
+func (s *SqliteDB) GetOrCreateSource(name string) (*models.GitSource, error) {
+	row, err := s.queries.GetOrCreateSource(context.Background(), name)
 	if err != nil {
-		return fmt.Errorf("error inserting user: %v", err)
+		return nil, fmt.Errorf("error upserting source: %v", err)
 	}
 
-	id, _ := row.LastInsertId()
-
-	user.ID = int(id)
-	return nil
+	return &models.GitSource{ID: int(row.ID), Name: row.Name}, nil
 }
 
 // GetOrCreateUser is a convenience method to get the provided User,
-// or create it if it doesn't exist.
+// or create it if it doesn't exist, keyed on the URL unique constraint.
 func (s *SqliteDB) GetOrCreateUser(user *models.GitUser) error {
-	err := s.GetUserID(user)
-
-	if err == sql.ErrNoRows {
-		return s.CreateUser(user)
-	}
-
-	return err
-}
-
-// ------------------------------------------------------------------
-// Get or Create Repo.
-
-// GetRepoID retrieves a Repo ID, using the URL as the unique constraint.
-func (s *SqliteDB) GetRepoID(repo *models.GitRepo) error {
-	sql := `SELECT id FROM git_repo WHERE url = ?;`
-
-	return s.DB.QueryRow(sql, repo.URL).Scan(&repo.ID)
-}
-
-// CreateRepo inserts a new Repo row and returns the ID.
-func (s *SqliteDB) CreateRepo(repo *models.GitRepo) error {
-	sql := `
-		INSERT INTO git_repo ("source_id", "name", "description", "url")
-		VALUES (:source_id, :name, :description, :url);`
-
-	row, err := s.DB.NamedExec(sql, repo)
-
+	row, err := s.queries.GetOrCreateUser(context.Background(), sqlitegen.GetOrCreateUserParams{
+		SourceID:  int64(user.SourceID),
+		Username:  user.Username,
+		Url:       user.URL,
+		AvatarUrl: user.AvatarURL,
+	})
 	if err != nil {
-		return fmt.Errorf("error inserting repo: %v", err)
+		return fmt.Errorf("error upserting user: %v", err)
 	}
 
-	id, _ := row.LastInsertId()
-
-	repo.ID = int(id)
+	user.ID = int(row.ID)
 	return nil
 }
 
 // GetOrCreateRepo is a convenience method to get the provided Repo,
-// or create it if it doesn't exist.
+// or create it if it doesn't exist, keyed on the URL unique constraint.
 func (s *SqliteDB) GetOrCreateRepo(repo *models.GitRepo) error {
-	err := s.GetRepoID(repo)
-
-	if err == sql.ErrNoRows {
-		return s.CreateRepo(repo)
-	}
-
-	return err
-}
-
-// ------------------------------------------------------------------
-// Get or Create Commit.
-
-// GetCommitID retrieves a Commit ID, using the URL as the unique constraint.
-func (s *SqliteDB) GetCommitID(commit *models.GitCommit) error {
-	sql := `SELECT id FROM git_commit WHERE url = ?;`
-
-	return s.DB.QueryRow(sql, commit.URL).Scan(&commit.ID)
-}
-
-// CreateCommit inserts a new Commit row and returns the ID.
-func (s *SqliteDB) CreateCommit(commit *models.GitCommit) error {
-	sql := `
-		INSERT INTO git_commit (
-			"source_id", "author_id", "repo_id", "message", "sha", "url", "date"
-		)
-		VALUES (:source_id, :author_id, :repo_id, :message, :sha, :url, :date);`
-
-	row, err := s.DB.NamedExec(sql, commit)
-
+	row, err := s.queries.GetOrCreateRepo(context.Background(), sqlitegen.GetOrCreateRepoParams{
+		SourceID:    int64(repo.SourceID),
+		Name:        repo.Name,
+		Description: repo.Description,
+		Url:         repo.URL,
+	})
 	if err != nil {
-		return fmt.Errorf("error inserting commit: %v", err)
+		return fmt.Errorf("error upserting repo: %v", err)
 	}
 
-	id, _ := row.LastInsertId()
-
-	commit.ID = int(id)
+	repo.ID = int(row.ID)
 	return nil
 }
 
 // GetOrCreateCommit is a convenience method to get the provided Commit,
-// or create it if it doesn't exist.
+// or create it if it doesn't exist, keyed on the URL unique constraint.
 func (s *SqliteDB) GetOrCreateCommit(commit *models.GitCommit) error {
-	err := s.GetCommitID(commit)
-
-	if err == sql.ErrNoRows {
-		return s.CreateCommit(commit)
+	row, err := s.queries.GetOrCreateCommit(context.Background(), sqlitegen.GetOrCreateCommitParams{
+		SourceID: int64(commit.SourceID),
+		AuthorID: int64(commit.AuthorID),
+		RepoID:   int64(commit.RepoID),
+		Message:  commit.Message,
+		Sha:      commit.SHA,
+		Url:      commit.URL,
+		Date:     commit.Date,
+	})
+	if err != nil {
+		return fmt.Errorf("error upserting commit: %v", err)
 	}
 
-	return err
+	commit.ID = int(row.ID)
+	return nil
 }
 
 // ------------------------------------------------------------------
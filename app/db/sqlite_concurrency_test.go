@@ -0,0 +1,91 @@
+package db_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tunedmystic/commits.lol/app/db"
+	"github.com/tunedmystic/commits.lol/app/models"
+)
+
+// TestSqliteDB_ConcurrentWritesAndReads hammers GetOrCreateCommit from N
+// goroutines while RecentCommits runs concurrently, to prove the WAL mode
+// plus single-connection write handle / separate read handle actually
+// avoids "database is locked" errors under concurrent ingest + web traffic.
+func TestSqliteDB_ConcurrentWritesAndReads(t *testing.T) {
+	const goroutines = 20
+	const commitsPerGoroutine = 25
+
+	name := filepath.Join(t.TempDir(), "commits.db")
+	sdb := db.NewSqliteDB(name)
+
+	user := models.GitUser{
+		SourceID:  1,
+		Username:  "octocat",
+		URL:       "https://github.com/octocat",
+		AvatarURL: "https://github.com/octocat.png",
+	}
+	if err := sdb.GetOrCreateUser(&user); err != nil {
+		t.Fatalf("error creating user: %v", err)
+	}
+
+	repo := models.GitRepo{
+		SourceID:    1,
+		Name:        "hello-world",
+		Description: "My first repository",
+		URL:         "https://github.com/octocat/hello-world",
+	}
+	if err := sdb.GetOrCreateRepo(&repo); err != nil {
+		t.Fatalf("error creating repo: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines*commitsPerGoroutine+goroutines)
+
+	// Writers: hammer GetOrCreateCommit concurrently.
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < commitsPerGoroutine; i++ {
+				commit := models.GitCommit{
+					SourceID: 1,
+					AuthorID: user.ID,
+					RepoID:   repo.ID,
+					Message:  "commit",
+					SHA:      fmt.Sprintf("sha-%v-%v", g, i),
+					URL:      fmt.Sprintf("https://github.com/octocat/hello-world/commit/%v-%v", g, i),
+					Date:     time.Now().UTC(),
+				}
+				if err := sdb.GetOrCreateCommit(&commit); err != nil {
+					errs <- err
+				}
+			}
+		}(g)
+	}
+
+	// Readers: run RecentCommits concurrently with the writers above.
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := sdb.RecentCommits(); err != nil {
+				errs <- err
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if strings.Contains(err.Error(), "database is locked") {
+			t.Fatalf("unexpected database is locked error: %v", err)
+		}
+		t.Errorf("unexpected error: %v", err)
+	}
+}
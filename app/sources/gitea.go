@@ -0,0 +1,155 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/tunedmystic/commits.lol/app/clients"
+	"github.com/tunedmystic/commits.lol/app/models"
+)
+
+// GiteaCommitSearchResponse is the response shape of Gitea/Forgejo's
+// GET /api/v1/repos/search-commits endpoint.
+type GiteaCommitSearchResponse struct {
+	Commits []GiteaCommit `json:"commits"`
+}
+
+// GiteaCommit ...
+type GiteaCommit struct {
+	URL    string            `json:"html_url"`
+	SHA    string            `json:"sha"`
+	Commit GiteaCommitDetail `json:"commit"`
+	Author GiteaUser         `json:"author"`
+	Repo   GiteaRepository   `json:"repository"`
+}
+
+// GiteaCommitDetail ...
+type GiteaCommitDetail struct {
+	Message string          `json:"message"`
+	Author  GiteaCommitMeta `json:"author"`
+}
+
+// GiteaCommitMeta ...
+type GiteaCommitMeta struct {
+	Date string `json:"date"`
+}
+
+// GiteaUser ...
+type GiteaUser struct {
+	Login     string `json:"login"`
+	AvatarURL string `json:"avatar_url"`
+	HTMLURL   string `json:"html_url"`
+}
+
+// GiteaRepository ...
+type GiteaRepository struct {
+	Name    string    `json:"name"`
+	HTMLURL string    `json:"html_url"`
+	Owner   GiteaUser `json:"owner"`
+}
+
+// GiteaSearcher searches a self-hosted Gitea or Forgejo instance for
+// commits. BaseURL points at the instance root, e.g. "https://try.gogits.org".
+type GiteaSearcher struct {
+	BaseURL    string
+	SourceID   int
+	HTTPClient *http.Client
+}
+
+// NewGiteaSearcher returns a CommitSearcher for a Gitea/Forgejo instance.
+func NewGiteaSearcher(baseURL string, sourceID int) *GiteaSearcher {
+	return &GiteaSearcher{
+		BaseURL:    baseURL,
+		SourceID:   sourceID,
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+// Name identifies this backend.
+func (g *GiteaSearcher) Name() string {
+	return "gitea"
+}
+
+// Search queries /api/v1/repos/search-commits and translates the results
+// into GitCommit models.
+func (g *GiteaSearcher) Search(ctx context.Context, opts SearchOptions) ([]models.GitCommit, error) {
+	endpoint := fmt.Sprintf("%v/api/v1/repos/search-commits?%v", g.BaseURL, url.Values{
+		"q":    {opts.Query},
+		"page": {fmt.Sprint(opts.Page)},
+	}.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := g.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, clients.NewAPIError(g.Name(), endpoint, data, resp.StatusCode)
+	}
+
+	var searchResp GiteaCommitSearchResponse
+	if err := json.Unmarshal(data, &searchResp); err != nil {
+		return nil, err
+	}
+
+	commits := make([]models.GitCommit, 0, len(searchResp.Commits))
+	for _, item := range searchResp.Commits {
+		commit, err := g.toGitCommit(item)
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, commit)
+	}
+
+	return commits, nil
+}
+
+// toGitCommit translates a GiteaCommit into the shared GitCommit model.
+func (g *GiteaSearcher) toGitCommit(item GiteaCommit) (models.GitCommit, error) {
+	author := models.GitUser{
+		SourceID:  g.SourceID,
+		Username:  item.Author.Login,
+		URL:       item.Author.HTMLURL,
+		AvatarURL: item.Author.AvatarURL,
+	}
+
+	repo := models.GitRepo{
+		SourceID: g.SourceID,
+		Name:     item.Repo.Name,
+		URL:      item.Repo.HTMLURL,
+	}
+
+	date, err := time.Parse(time.RFC3339, item.Commit.Author.Date)
+	if err != nil {
+		return models.GitCommit{}, fmt.Errorf("error parsing commit date %q: %w", item.Commit.Author.Date, err)
+	}
+
+	return models.GitCommit{
+		SourceID: g.SourceID,
+		Message:  item.Commit.Message,
+		SHA:      item.SHA,
+		URL:      item.URL,
+		Date:     date,
+		Author:   &author,
+		Repo:     &repo,
+	}, nil
+}
+
+// Ensure GiteaSearcher satisfies the CommitSearcher interface.
+var _ CommitSearcher = &GiteaSearcher{}
@@ -0,0 +1,109 @@
+package sources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tunedmystic/commits.lol/app/clients"
+)
+
+func TestGiteaSearcher_Search(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"commits": [
+				{
+					"html_url": "https://gitea.example.com/acme/widgets/commit/abc123",
+					"sha": "abc123",
+					"commit": {
+						"message": "fix widget alignment",
+						"author": {"date": "2024-01-02T15:04:05Z"}
+					},
+					"author": {
+						"login": "alice",
+						"avatar_url": "https://gitea.example.com/avatars/alice",
+						"html_url": "https://gitea.example.com/alice"
+					},
+					"repository": {
+						"name": "widgets",
+						"html_url": "https://gitea.example.com/acme/widgets"
+					}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	searcher := NewGiteaSearcher(server.URL, 1)
+	commits, err := searcher.Search(context.Background(), SearchOptions{Query: "widget", Page: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(commits) != 1 {
+		t.Fatalf("expected 1 commit, got %d", len(commits))
+	}
+
+	commit := commits[0]
+	if commit.SHA != "abc123" {
+		t.Errorf("SHA = %q, want %q", commit.SHA, "abc123")
+	}
+	if commit.Message != "fix widget alignment" {
+		t.Errorf("Message = %q, want %q", commit.Message, "fix widget alignment")
+	}
+	if commit.Author.URL != "https://gitea.example.com/alice" {
+		t.Errorf("Author.URL = %q, want %q", commit.Author.URL, "https://gitea.example.com/alice")
+	}
+	if !commit.Date.Equal(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)) {
+		t.Errorf("Date = %v, want 2024-01-02T15:04:05Z", commit.Date)
+	}
+}
+
+func TestGiteaSearcher_Search_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "rate limited"}`))
+	}))
+	defer server.Close()
+
+	searcher := NewGiteaSearcher(server.URL, 1)
+	_, err := searcher.Search(context.Background(), SearchOptions{Query: "widget", Page: 1})
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+
+	apiErr, ok := err.(*clients.APIError)
+	if !ok {
+		t.Fatalf("expected *clients.APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusForbidden)
+	}
+}
+
+func TestGiteaSearcher_Search_InvalidDate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"commits": [
+				{
+					"html_url": "https://gitea.example.com/acme/widgets/commit/abc123",
+					"sha": "abc123",
+					"commit": {
+						"message": "fix widget alignment",
+						"author": {"date": "not-a-date"}
+					},
+					"author": {"login": "alice"},
+					"repository": {"name": "widgets"}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	searcher := NewGiteaSearcher(server.URL, 1)
+	_, err := searcher.Search(context.Background(), SearchOptions{Query: "widget", Page: 1})
+	if err == nil {
+		t.Fatal("expected a date-parse error, got nil")
+	}
+}
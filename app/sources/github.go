@@ -0,0 +1,104 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/tunedmystic/commits.lol/app/clients/github"
+	"github.com/tunedmystic/commits.lol/app/models"
+)
+
+// GitHubSearcher searches GitHub's commit search API.
+type GitHubSearcher struct {
+	BaseURL    string
+	SourceID   int
+	HTTPClient *http.Client
+}
+
+// NewGitHubSearcher returns a CommitSearcher for GitHub.
+func NewGitHubSearcher(sourceID int) *GitHubSearcher {
+	return &GitHubSearcher{
+		BaseURL:    "https://api.github.com",
+		SourceID:   sourceID,
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+// Name identifies this backend.
+func (g *GitHubSearcher) Name() string {
+	return "github"
+}
+
+// Search queries /search/commits and translates the results into
+// GitCommit models.
+func (g *GitHubSearcher) Search(ctx context.Context, opts SearchOptions) ([]models.GitCommit, error) {
+	endpoint := fmt.Sprintf("%v/search/commits?%v", g.BaseURL, url.Values{
+		"q":    {opts.Query},
+		"page": {fmt.Sprint(opts.Page)},
+	}.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := g.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, github.NewAPIError(endpoint, data, resp.StatusCode)
+	}
+
+	var searchResp github.CommitSearchResponse
+	if err := json.Unmarshal(data, &searchResp); err != nil {
+		return nil, err
+	}
+
+	commits := make([]models.GitCommit, 0, len(searchResp.CommitItems))
+	for _, item := range searchResp.CommitItems {
+		commits = append(commits, g.toGitCommit(item))
+	}
+
+	return commits, nil
+}
+
+// toGitCommit translates a github.CommitItem into the shared GitCommit model.
+func (g *GitHubSearcher) toGitCommit(item github.CommitItem) models.GitCommit {
+	author := models.GitUser{
+		SourceID:  g.SourceID,
+		Username:  item.Author.Login,
+		URL:       item.Author.URL,
+		AvatarURL: item.Author.AvatarURL,
+	}
+
+	repo := models.GitRepo{
+		SourceID: g.SourceID,
+		Name:     item.Repo.Name,
+		URL:      item.Repo.URL,
+	}
+
+	return models.GitCommit{
+		SourceID: g.SourceID,
+		Message:  item.Commit.Message,
+		SHA:      item.SHA,
+		URL:      item.URL,
+		Date:     item.Commit.Author.Date,
+		Author:   &author,
+		Repo:     &repo,
+	}
+}
+
+// Ensure GitHubSearcher satisfies the CommitSearcher interface.
+var _ CommitSearcher = &GitHubSearcher{}
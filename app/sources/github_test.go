@@ -0,0 +1,81 @@
+package sources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tunedmystic/commits.lol/app/clients/github"
+)
+
+func TestGitHubSearcher_Search(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"total_count": 1,
+			"items": [
+				{
+					"html_url": "https://github.com/acme/sprockets/commit/789xyz",
+					"sha": "789xyz",
+					"commit": {
+						"message": "add sprocket tests",
+						"author": {"date": "2024-05-06T12:00:00Z"}
+					},
+					"author": {
+						"login": "dave",
+						"avatar_url": "https://github.com/avatars/dave",
+						"html_url": "https://github.com/dave"
+					},
+					"repository": {
+						"name": "sprockets",
+						"html_url": "https://github.com/acme/sprockets"
+					}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	searcher := &GitHubSearcher{BaseURL: server.URL, SourceID: 3, HTTPClient: http.DefaultClient}
+	commits, err := searcher.Search(context.Background(), SearchOptions{Query: "sprocket", Page: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(commits) != 1 {
+		t.Fatalf("expected 1 commit, got %d", len(commits))
+	}
+
+	commit := commits[0]
+	if commit.SHA != "789xyz" {
+		t.Errorf("SHA = %q, want %q", commit.SHA, "789xyz")
+	}
+	if commit.Author.URL != "https://github.com/dave" {
+		t.Errorf("Author.URL = %q, want %q", commit.Author.URL, "https://github.com/dave")
+	}
+	if !commit.Date.Equal(time.Date(2024, 5, 6, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Date = %v, want 2024-05-06T12:00:00Z", commit.Date)
+	}
+}
+
+func TestGitHubSearcher_Search_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+	}))
+	defer server.Close()
+
+	searcher := &GitHubSearcher{BaseURL: server.URL, SourceID: 3, HTTPClient: http.DefaultClient}
+	_, err := searcher.Search(context.Background(), SearchOptions{Query: "sprocket", Page: 1})
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+
+	apiErr, ok := err.(*github.APIError)
+	if !ok {
+		t.Fatalf("expected *github.APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusTooManyRequests)
+	}
+}
@@ -0,0 +1,176 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/tunedmystic/commits.lol/app/clients"
+	"github.com/tunedmystic/commits.lol/app/models"
+)
+
+// GitLabSearchItem is one result from GET /api/v4/search?scope=commits.
+type GitLabSearchItem struct {
+	ID           string `json:"id"`
+	Message      string `json:"message"`
+	AuthorName   string `json:"author_name"`
+	AuthoredDate string `json:"authored_date"`
+	ProjectID    int    `json:"project_id"`
+}
+
+// GitLabProject is the response shape of GET /api/v4/projects/:id.
+type GitLabProject struct {
+	Name              string `json:"name"`
+	WebURL            string `json:"web_url"`
+	NameWithNamespace string `json:"name_with_namespace"`
+}
+
+// GitLabSearcher searches a GitLab instance (gitlab.com, or self-hosted
+// via BaseURL) for commits.
+type GitLabSearcher struct {
+	BaseURL    string
+	SourceID   int
+	HTTPClient *http.Client
+}
+
+// NewGitLabSearcher returns a CommitSearcher for a GitLab instance.
+func NewGitLabSearcher(baseURL string, sourceID int) *GitLabSearcher {
+	return &GitLabSearcher{
+		BaseURL:    baseURL,
+		SourceID:   sourceID,
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+// Name identifies this backend.
+func (g *GitLabSearcher) Name() string {
+	return "gitlab"
+}
+
+// Search queries /api/v4/search?scope=commits and translates the results
+// into GitCommit models.
+func (g *GitLabSearcher) Search(ctx context.Context, opts SearchOptions) ([]models.GitCommit, error) {
+	endpoint := fmt.Sprintf("%v/api/v4/search?%v", g.BaseURL, url.Values{
+		"scope":  {"commits"},
+		"search": {opts.Query},
+		"page":   {fmt.Sprint(opts.Page)},
+	}.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := g.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, clients.NewAPIError(g.Name(), endpoint, data, resp.StatusCode)
+	}
+
+	var items []GitLabSearchItem
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+
+	commits := make([]models.GitCommit, 0, len(items))
+	for _, item := range items {
+		commit, err := g.toGitCommit(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, commit)
+	}
+
+	return commits, nil
+}
+
+// toGitCommit translates a GitLabSearchItem into the shared GitCommit model,
+// fetching the owning project to fill in the GitRepo (GitLab's search
+// response doesn't embed it like GitHub/Gitea do).
+func (g *GitLabSearcher) toGitCommit(ctx context.Context, item GitLabSearchItem) (models.GitCommit, error) {
+	project, err := g.project(ctx, item.ProjectID)
+	if err != nil {
+		return models.GitCommit{}, err
+	}
+
+	// GitLab's commits-search payload has no author profile URL, but
+	// git_user.url is unique and GetOrCreateUser upserts on it, so we
+	// synthesize a stable per-author URL instead of leaving it blank
+	// (an empty URL would collide every GitLab author into one row).
+	author := models.GitUser{
+		SourceID: g.SourceID,
+		Username: item.AuthorName,
+		URL:      fmt.Sprintf("%v/%v", strings.TrimRight(g.BaseURL, "/"), item.AuthorName),
+	}
+
+	repo := models.GitRepo{
+		SourceID: g.SourceID,
+		Name:     project.Name,
+		URL:      project.WebURL,
+	}
+
+	date, err := time.Parse(time.RFC3339, item.AuthoredDate)
+	if err != nil {
+		return models.GitCommit{}, fmt.Errorf("error parsing commit date %q: %w", item.AuthoredDate, err)
+	}
+	commitURL := fmt.Sprintf("%v/-/commit/%v", strings.TrimRight(project.WebURL, "/"), item.ID)
+
+	return models.GitCommit{
+		SourceID: g.SourceID,
+		Message:  item.Message,
+		SHA:      item.ID,
+		URL:      commitURL,
+		Date:     date,
+		Author:   &author,
+		Repo:     &repo,
+	}, nil
+}
+
+// project fetches a GitLab project by ID.
+func (g *GitLabSearcher) project(ctx context.Context, id int) (*GitLabProject, error) {
+	endpoint := fmt.Sprintf("%v/api/v4/projects/%v", g.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := g.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, clients.NewAPIError(g.Name(), endpoint, data, resp.StatusCode)
+	}
+
+	var project GitLabProject
+	if err := json.Unmarshal(data, &project); err != nil {
+		return nil, err
+	}
+
+	return &project, nil
+}
+
+// Ensure GitLabSearcher satisfies the CommitSearcher interface.
+var _ CommitSearcher = &GitLabSearcher{}
@@ -0,0 +1,131 @@
+package sources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tunedmystic/commits.lol/app/clients"
+)
+
+func TestGitLabSearcher_Search(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/api/v4/search"):
+			w.Write([]byte(`[
+				{
+					"id": "def456",
+					"message": "tighten search query",
+					"author_name": "bob",
+					"authored_date": "2024-03-04T10:20:30Z",
+					"project_id": 42
+				}
+			]`))
+		case strings.HasPrefix(r.URL.Path, "/api/v4/projects/42"):
+			w.Write([]byte(`{
+				"name": "gadgets",
+				"web_url": "https://gitlab.example.com/acme/gadgets",
+				"name_with_namespace": "acme / gadgets"
+			}`))
+		default:
+			t.Fatalf("unexpected request path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	searcher := NewGitLabSearcher(server.URL, 2)
+	commits, err := searcher.Search(context.Background(), SearchOptions{Query: "gadget", Page: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(commits) != 1 {
+		t.Fatalf("expected 1 commit, got %d", len(commits))
+	}
+
+	commit := commits[0]
+	if commit.SHA != "def456" {
+		t.Errorf("SHA = %q, want %q", commit.SHA, "def456")
+	}
+	if commit.URL != "https://gitlab.example.com/acme/gadgets/-/commit/def456" {
+		t.Errorf("URL = %q, want commit URL under the project's web_url", commit.URL)
+	}
+	wantAuthorURL := server.URL + "/bob"
+	if commit.Author.URL != wantAuthorURL {
+		t.Errorf("Author.URL = %q, want %q", commit.Author.URL, wantAuthorURL)
+	}
+	if !commit.Date.Equal(time.Date(2024, 3, 4, 10, 20, 30, 0, time.UTC)) {
+		t.Errorf("Date = %v, want 2024-03-04T10:20:30Z", commit.Date)
+	}
+}
+
+func TestGitLabSearcher_Search_DistinctAuthorsGetDistinctURLs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/api/v4/search"):
+			w.Write([]byte(`[
+				{"id": "aaa", "message": "a", "author_name": "bob", "authored_date": "2024-03-04T10:20:30Z", "project_id": 1},
+				{"id": "bbb", "message": "b", "author_name": "carol", "authored_date": "2024-03-05T10:20:30Z", "project_id": 1}
+			]`))
+		case strings.HasPrefix(r.URL.Path, "/api/v4/projects/1"):
+			w.Write([]byte(`{"name": "gadgets", "web_url": "https://gitlab.example.com/acme/gadgets"}`))
+		}
+	}))
+	defer server.Close()
+
+	searcher := NewGitLabSearcher(server.URL, 2)
+	commits, err := searcher.Search(context.Background(), SearchOptions{Query: "gadget", Page: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(commits) != 2 {
+		t.Fatalf("expected 2 commits, got %d", len(commits))
+	}
+	if commits[0].Author.URL == commits[1].Author.URL {
+		t.Errorf("distinct authors got the same URL: %q", commits[0].Author.URL)
+	}
+}
+
+func TestGitLabSearcher_Search_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message": "invalid token"}`))
+	}))
+	defer server.Close()
+
+	searcher := NewGitLabSearcher(server.URL, 1)
+	_, err := searcher.Search(context.Background(), SearchOptions{Query: "gadget", Page: 1})
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+
+	apiErr, ok := err.(*clients.APIError)
+	if !ok {
+		t.Fatalf("expected *clients.APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusUnauthorized)
+	}
+}
+
+func TestGitLabSearcher_Search_InvalidDate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/api/v4/search"):
+			w.Write([]byte(`[
+				{"id": "aaa", "message": "a", "author_name": "bob", "authored_date": "not-a-date", "project_id": 1}
+			]`))
+		case strings.HasPrefix(r.URL.Path, "/api/v4/projects/1"):
+			w.Write([]byte(`{"name": "gadgets", "web_url": "https://gitlab.example.com/acme/gadgets"}`))
+		}
+	}))
+	defer server.Close()
+
+	searcher := NewGitLabSearcher(server.URL, 1)
+	_, err := searcher.Search(context.Background(), SearchOptions{Query: "gadget", Page: 1})
+	if err == nil {
+		t.Fatal("expected a date-parse error, got nil")
+	}
+}
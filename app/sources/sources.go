@@ -0,0 +1,60 @@
+// Package sources provides a pluggable interface for searching commits
+// across different git hosting providers (GitHub, Gitea/Forgejo, GitLab, ...)
+// and translating their responses into the shared models types.
+package sources
+
+import (
+	"context"
+
+	"github.com/tunedmystic/commits.lol/app/models"
+)
+
+// SearchOptions controls how a CommitSearcher runs its search.
+type SearchOptions struct {
+	Query string
+	Page  int
+}
+
+// CommitSearcher searches a git host for commits matching a query, and
+// returns them as GitCommit models with their nested GitUser/GitRepo set.
+type CommitSearcher interface {
+	// Name identifies the backend, e.g. "github", "gitea", "gitlab".
+	Name() string
+
+	// Search runs a commit search against the backend.
+	Search(ctx context.Context, opts SearchOptions) ([]models.GitCommit, error)
+}
+
+// RoundRobin is a CommitSearcher that cycles through a list of configured
+// searchers, one per call to Search, so a caller can drive every configured
+// source from a single CommitSearcher without knowing how many backends
+// are configured.
+type RoundRobin struct {
+	searchers []CommitSearcher
+	next      int
+}
+
+// NewRoundRobin returns a RoundRobin over the given searchers.
+func NewRoundRobin(searchers ...CommitSearcher) *RoundRobin {
+	return &RoundRobin{searchers: searchers}
+}
+
+// Name returns "round-robin", since this searcher speaks for all of them.
+func (r *RoundRobin) Name() string {
+	return "round-robin"
+}
+
+// Search delegates to the next searcher in rotation.
+func (r *RoundRobin) Search(ctx context.Context, opts SearchOptions) ([]models.GitCommit, error) {
+	if len(r.searchers) == 0 {
+		return nil, nil
+	}
+
+	searcher := r.searchers[r.next%len(r.searchers)]
+	r.next++
+
+	return searcher.Search(ctx, opts)
+}
+
+// Ensure RoundRobin satisfies the CommitSearcher interface.
+var _ CommitSearcher = &RoundRobin{}
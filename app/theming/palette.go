@@ -0,0 +1,15 @@
+// Package theming picks a stable display color for a commit's author,
+// independent of the GitCommit model itself.
+package theming
+
+// ColorPair is a background/foreground color combination.
+type ColorPair struct {
+	Background string `json:"background"`
+	Foreground string `json:"foreground"`
+}
+
+// Palette is a named, ordered set of color pairs, e.g. "canada" or "india".
+type Palette struct {
+	Name   string      `json:"name"`
+	Colors []ColorPair `json:"colors"`
+}
@@ -0,0 +1,56 @@
+package theming
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"os"
+
+	"github.com/tunedmystic/commits.lol/app/models"
+)
+
+// Store holds the loaded palettes, keyed by name.
+type Store struct {
+	palettes map[string]Palette
+}
+
+// NewStore reads palettes from a JSON file and returns a new *Store.
+func NewStore(path string) (*Store, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading palettes file: %w", err)
+	}
+
+	var palettes []Palette
+	if err := json.Unmarshal(data, &palettes); err != nil {
+		return nil, fmt.Errorf("error unmarshalling palettes: %w", err)
+	}
+
+	store := Store{palettes: make(map[string]Palette, len(palettes))}
+	for _, p := range palettes {
+		store.palettes[p.Name] = p
+	}
+
+	return &store, nil
+}
+
+// Theme picks a stable ColorPair for the commit's author from the named
+// palette. The color is chosen by an FNV-1a hash of the author URL, so
+// each author keeps the same color across renders and across edits to
+// the commit message.
+func (s *Store) Theme(commit *models.GitCommit, paletteName string) (ColorPair, error) {
+	palette, ok := s.palettes[paletteName]
+	if !ok {
+		return ColorPair{}, fmt.Errorf("no palette named %v", paletteName)
+	}
+
+	if len(palette.Colors) == 0 {
+		return ColorPair{}, fmt.Errorf("palette %v has no colors", paletteName)
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(commit.Author.URL))
+
+	index := int(h.Sum32() % uint32(len(palette.Colors)))
+	return palette.Colors[index], nil
+}
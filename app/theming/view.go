@@ -0,0 +1,24 @@
+package theming
+
+import (
+	"github.com/tunedmystic/commits.lol/app/models"
+)
+
+// CommitView pairs a GitCommit with the color it should render with. The
+// color lives here instead of on models.GitCommit since it's a rendering
+// concern, not something that belongs in the database.
+type CommitView struct {
+	*models.GitCommit
+	Color ColorPair
+}
+
+// NewCommitView themes a commit with the named palette and returns a
+// CommitView ready for rendering.
+func (s *Store) NewCommitView(commit *models.GitCommit, paletteName string) (CommitView, error) {
+	color, err := s.Theme(commit, paletteName)
+	if err != nil {
+		return CommitView{}, err
+	}
+
+	return CommitView{GitCommit: commit, Color: color}, nil
+}